@@ -61,4 +61,8 @@ export interface FeatureToggles {
   datasourceQueryMultiStatus?: boolean;
   azureMonitorExperimentalUI?: boolean;
   traceToMetrics?: boolean;
+  libraryCredentials?: boolean;
+  libraryCredentialsProvisioning?: boolean;
+  libraryCredentialsExternalBackends?: boolean;
+  libraryCredentialsProxyResolution?: boolean;
 }
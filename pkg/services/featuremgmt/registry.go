@@ -254,5 +254,27 @@ var (
 			State:        FeatureStateAlpha,
 			FrontendOnly: true,
 		},
+		{
+			Name:            "libraryCredentials",
+			Description:     "Enable library credentials that can be shared between datasources",
+			State:           FeatureStateAlpha,
+			RequiresRestart: true,
+		},
+		{
+			Name:            "libraryCredentialsProvisioning",
+			Description:     "Provision library credentials from config files (requires libraryCredentials)",
+			State:           FeatureStateAlpha,
+			RequiresRestart: true,
+		},
+		{
+			Name:        "libraryCredentialsExternalBackends",
+			Description: "Resolve library credential secrets from external secret stores (requires libraryCredentials)",
+			State:       FeatureStateAlpha,
+		},
+		{
+			Name:        "libraryCredentialsProxyResolution",
+			Description: "Resolve library credentials in the datasource proxy (requires libraryCredentials)",
+			State:       FeatureStateAlpha,
+		},
 	}
 )
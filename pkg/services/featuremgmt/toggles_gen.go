@@ -186,4 +186,20 @@ const (
 	// FlagTraceToMetrics
 	// Enable trace to metrics links
 	FlagTraceToMetrics = "traceToMetrics"
+
+	// FlagLibraryCredentials
+	// Enable library credentials that can be shared between datasources
+	FlagLibraryCredentials = "libraryCredentials"
+
+	// FlagLibraryCredentialsProvisioning
+	// Provision library credentials from config files (requires libraryCredentials)
+	FlagLibraryCredentialsProvisioning = "libraryCredentialsProvisioning"
+
+	// FlagLibraryCredentialsExternalBackends
+	// Resolve library credential secrets from external secret stores (requires libraryCredentials)
+	FlagLibraryCredentialsExternalBackends = "libraryCredentialsExternalBackends"
+
+	// FlagLibraryCredentialsProxyResolution
+	// Resolve library credentials in the datasource proxy (requires libraryCredentials)
+	FlagLibraryCredentialsProxyResolution = "libraryCredentialsProxyResolution"
 )